@@ -0,0 +1,116 @@
+// Command monty is the monty-standards CLI entry point. It exposes the
+// greet, add, and version subcommands over the standards, greet, and
+// mathx packages.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MontyForged/monty-standards/greet"
+	"github.com/MontyForged/monty-standards/mathx"
+)
+
+// Exit codes returned by run.
+const (
+	exitSuccess = 0
+	exitUsage   = 2
+	exitError   = 11
+)
+
+// version is the CLI's reported version.
+const version = "0.1.0"
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: monty <greet|add|version> [flags]")
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "greet":
+		return runGreet(args[1:], stdout, stderr)
+	case "add":
+		return runAdd(args[1:], stdout, stderr)
+	case "version":
+		fmt.Fprintln(stdout, version)
+		return exitSuccess
+	default:
+		fmt.Fprintf(stderr, "monty: unknown command %q\n", args[0])
+		return exitUsage
+	}
+}
+
+func runGreet(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("greet", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	name := fs.String("name", "World", "name of the person to greet")
+	lang := fs.String("lang", greet.DefaultLocale, "locale tag for the greeting")
+	format := fs.String("format", "plain", "output format: plain, json, or yaml")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	f, err := parseFormat(*format)
+	if err != nil {
+		fmt.Fprintln(stderr, "monty:", err)
+		return exitUsage
+	}
+
+	g := greet.NewGreeter(greet.WithLocale(*lang), greet.WithFormat(f))
+	msg, err := g.Greet(*name)
+	if err != nil {
+		fmt.Fprintln(stderr, "monty:", err)
+		return exitError
+	}
+
+	fmt.Fprintln(stdout, msg)
+	return exitSuccess
+}
+
+func parseFormat(s string) (greet.Format, error) {
+	switch strings.ToLower(s) {
+	case "plain":
+		return greet.FormatPlain, nil
+	case "json":
+		return greet.FormatJSON, nil
+	case "yaml":
+		return greet.FormatYAML, nil
+	default:
+		return 0, fmt.Errorf("unknown format %q", s)
+	}
+}
+
+func runAdd(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: monty add <n>...")
+		return exitUsage
+	}
+
+	var sum int64
+	for _, arg := range args {
+		n, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			fmt.Fprintf(stderr, "monty: invalid number %q\n", arg)
+			return exitUsage
+		}
+
+		var err2 error
+		sum, err2 = mathx.Add(sum, n)
+		if err2 != nil {
+			fmt.Fprintln(stderr, "monty:", err2)
+			return exitError
+		}
+	}
+
+	fmt.Fprintln(stdout, sum)
+	return exitSuccess
+}
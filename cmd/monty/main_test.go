@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+	}{
+		{"greet default", []string{"greet"}, exitSuccess, "Hello, World!\n"},
+		{"greet unknown format", []string{"greet", "--format=xml"}, exitUsage, ""},
+		{"add", []string{"add", "2", "3", "4"}, exitSuccess, "9\n"},
+		{"add bad number", []string{"add", "2", "x"}, exitUsage, ""},
+		{"version", []string{"version"}, exitSuccess, "0.1.0\n"},
+		{"no args", nil, exitUsage, ""},
+		{"unknown command", []string{"bogus"}, exitUsage, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("run(%v) exit code = %d, want %d (stderr: %s)", tt.args, code, tt.wantCode, stderr.String())
+			}
+			if tt.wantStdout != "" && stdout.String() != tt.wantStdout {
+				t.Errorf("run(%v) stdout = %q, want %q", tt.args, stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
+
+// TestBinaryGolden builds the monty binary and exercises it end to end via
+// os/exec, comparing its stdout against golden files under testdata/.
+func TestBinaryGolden(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping binary build in -short mode")
+	}
+
+	bin := filepath.Join(t.TempDir(), "monty")
+	build := exec.Command("go", "build", "-o", bin, ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		golden string
+	}{
+		{"greet plain", []string{"greet"}, "greet_plain.golden"},
+		{"greet json", []string{"greet", "--name=Ada", "--lang=es", "--format=json"}, "greet_json.golden"},
+		{"add", []string{"add", "2", "3", "4"}, "add.golden"},
+		{"version", []string{"version"}, "version.golden"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := exec.Command(bin, tt.args...).Output()
+			if err != nil {
+				t.Fatalf("%s: %v", bin, err)
+			}
+
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatalf("reading golden file: %v", err)
+			}
+			if string(out) != string(want) {
+				t.Errorf("%s %v = %q, want %q", bin, tt.args, out, want)
+			}
+		})
+	}
+}
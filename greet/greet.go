@@ -0,0 +1,146 @@
+// Package greet builds greeting messages for one or more locales and
+// renders them in a handful of output formats.
+package greet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"text/template"
+)
+
+// Format selects how a Greeter renders its output.
+type Format int
+
+const (
+	// FormatPlain renders the greeting as plain text (the default).
+	FormatPlain Format = iota
+	// FormatJSON renders the greeting as a JSON object.
+	FormatJSON
+	// FormatYAML renders the greeting as a minimal YAML document.
+	FormatYAML
+)
+
+// DefaultLocale is used when no locale is configured.
+const DefaultLocale = "en"
+
+var (
+	mu      sync.RWMutex
+	locales = map[string]string{
+		"en": "Hello, %s!",
+		"es": "¡Hola, %s!",
+		"ja": "こんにちは、%sさん!",
+	}
+)
+
+// RegisterLocale adds or overrides the greeting phrase for tag. The phrase
+// must contain exactly one %s verb for the recipient's name. Callers
+// outside this package (third-party locale packs) use this to extend the
+// set of supported locales at init time.
+func RegisterLocale(tag, phrase string) {
+	mu.Lock()
+	defer mu.Unlock()
+	locales[tag] = phrase
+}
+
+func phraseFor(tag string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	phrase, ok := locales[tag]
+	return phrase, ok
+}
+
+// Greeter renders greetings for a configured locale, format, and (optional)
+// custom template.
+type Greeter struct {
+	locale      string
+	format      Format
+	hasTemplate bool
+	tmpl        *template.Template
+	tmplErr     error
+}
+
+// Option configures a Greeter.
+type Option func(*Greeter)
+
+// WithLocale sets the locale tag used to look up the greeting phrase.
+// Unknown tags fall back to DefaultLocale at Greet time.
+func WithLocale(tag string) Option {
+	return func(g *Greeter) {
+		g.locale = tag
+	}
+}
+
+// WithFormat sets the output format.
+func WithFormat(f Format) Option {
+	return func(g *Greeter) {
+		g.format = f
+	}
+}
+
+// WithTemplate overrides the rendered output with a user-supplied
+// text/template string. The template is executed with a struct exposing
+// .Name and .Message fields; if text fails to parse, Greet returns the
+// parse error. When set, it takes precedence over format.
+func WithTemplate(text string) Option {
+	return func(g *Greeter) {
+		g.hasTemplate = true
+		g.tmpl, g.tmplErr = template.New("greet").Parse(text)
+	}
+}
+
+// NewGreeter builds a Greeter from opts. It defaults to DefaultLocale and
+// FormatPlain.
+func NewGreeter(opts ...Option) *Greeter {
+	g := &Greeter{
+		locale: DefaultLocale,
+		format: FormatPlain,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// greeting is the data passed to JSON, YAML, and template rendering.
+type greeting struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// Greet renders a greeting for name according to the Greeter's configured
+// locale, format, and template.
+func (g *Greeter) Greet(name string) (string, error) {
+	phrase, ok := phraseFor(g.locale)
+	if !ok {
+		phrase, _ = phraseFor(DefaultLocale)
+	}
+	data := greeting{Name: name, Message: fmt.Sprintf(phrase, name)}
+
+	if g.hasTemplate {
+		if g.tmplErr != nil {
+			return "", fmt.Errorf("greet: parse template: %w", g.tmplErr)
+		}
+		var buf bytes.Buffer
+		if err := g.tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("greet: execute template: %w", err)
+		}
+		return buf.String(), nil
+	}
+
+	switch g.format {
+	case FormatJSON:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return "", fmt.Errorf("greet: marshal json: %w", err)
+		}
+		return string(b), nil
+	case FormatYAML:
+		return fmt.Sprintf("name: %s\nmessage: %s\n", data.Name, data.Message), nil
+	case FormatPlain:
+		return data.Message, nil
+	default:
+		return "", fmt.Errorf("greet: unknown format %v", g.format)
+	}
+}
@@ -0,0 +1,95 @@
+package greet
+
+import "testing"
+
+func TestGreeterLocalesAndFormats(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input string
+		want  string
+	}{
+		{
+			name:  "default english plain",
+			opts:  nil,
+			input: "World",
+			want:  "Hello, World!",
+		},
+		{
+			name:  "spanish plain",
+			opts:  []Option{WithLocale("es")},
+			input: "Mundo",
+			want:  "¡Hola, Mundo!",
+		},
+		{
+			name:  "japanese plain",
+			opts:  []Option{WithLocale("ja")},
+			input: "太郎",
+			want:  "こんにちは、太郎さん!",
+		},
+		{
+			name:  "unknown locale falls back to default",
+			opts:  []Option{WithLocale("xx")},
+			input: "World",
+			want:  "Hello, World!",
+		},
+		{
+			name:  "english json",
+			opts:  []Option{WithFormat(FormatJSON)},
+			input: "World",
+			want:  `{"name":"World","message":"Hello, World!"}`,
+		},
+		{
+			name:  "spanish yaml",
+			opts:  []Option{WithLocale("es"), WithFormat(FormatYAML)},
+			input: "Mundo",
+			want:  "name: Mundo\nmessage: ¡Hola, Mundo!\n",
+		},
+		{
+			name:  "custom template",
+			opts:  []Option{WithTemplate("{{.Message}} ({{.Name}})")},
+			input: "World",
+			want:  "Hello, World! (World)",
+		},
+		{
+			name:  "empty template renders empty, not the format default",
+			opts:  []Option{WithTemplate("")},
+			input: "World",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGreeter(tt.opts...)
+			got, err := g.Greet(tt.input)
+			if err != nil {
+				t.Fatalf("Greet(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTemplateInvalidTextReturnsError(t *testing.T) {
+	g := NewGreeter(WithTemplate("{{.Bogus"))
+
+	if _, err := g.Greet("World"); err == nil {
+		t.Fatal("Greet with an unparsable template returned no error")
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("fr", "Bonjour, %s!")
+	g := NewGreeter(WithLocale("fr"))
+
+	got, err := g.Greet("Monde")
+	if err != nil {
+		t.Fatalf("Greet returned error: %v", err)
+	}
+	if want := "Bonjour, Monde!"; got != want {
+		t.Errorf("Greet(%q) = %q, want %q", "Monde", got, want)
+	}
+}
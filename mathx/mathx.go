@@ -0,0 +1,319 @@
+// Package mathx provides arithmetic over fixed-width and arbitrary-precision
+// numeric types behind a single generic API. It is named mathx rather than
+// math so callers can import it alongside the standard library's math
+// package without aliasing.
+package mathx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// Number is the set of types mathx operates on: the two built-in numeric
+// kinds used for fixed-width arithmetic (including named types derived from
+// them, e.g. `type Meters int64`), and the two arbitrary-precision types
+// from math/big.
+type Number interface {
+	~int64 | ~float64 | *big.Int | *big.Float
+}
+
+// ErrDivideByZero is returned by Div when b is zero.
+var ErrDivideByZero = errors.New("mathx: division by zero")
+
+// OverflowError reports that an int64 or float64 operation produced a
+// result outside the representable range.
+type OverflowError struct {
+	Op   string
+	A, B string
+}
+
+// Error implements the error interface.
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("mathx: overflow computing %s(%s, %s)", e.Op, e.A, e.B)
+}
+
+// IsOverflow reports whether err is (or wraps) an *OverflowError.
+func IsOverflow(err error) bool {
+	var oe *OverflowError
+	return errors.As(err, &oe)
+}
+
+func overflow[T Number](op string, a, b T) *OverflowError {
+	return &OverflowError{Op: op, A: fmt.Sprint(a), B: fmt.Sprint(b)}
+}
+
+// asT converts v, a raw int64 or float64, back to T's underlying named
+// type. Used only by the named-type fallback path below.
+func asT[T Number](v any, rt reflect.Type) T {
+	return reflect.ValueOf(v).Convert(rt).Interface().(T)
+}
+
+// The addInt64/subInt64/... helpers hold the single implementation of each
+// operation's overflow check, shared by both the exact-type fast path and
+// the reflect-based fallback for named types (e.g. `type Meters int64`) so
+// the two paths can't drift out of sync.
+
+func addInt64(va, vb int64) (int64, bool) {
+	if (vb > 0 && va > math.MaxInt64-vb) || (vb < 0 && va < math.MinInt64-vb) {
+		return 0, true
+	}
+	return va + vb, false
+}
+
+func addFloat64(va, vb float64) (float64, bool) {
+	sum := va + vb
+	if math.IsInf(sum, 0) && !math.IsInf(va, 0) && !math.IsInf(vb, 0) {
+		return 0, true
+	}
+	return sum, false
+}
+
+func subInt64(va, vb int64) (int64, bool) {
+	if (vb < 0 && va > math.MaxInt64+vb) || (vb > 0 && va < math.MinInt64+vb) {
+		return 0, true
+	}
+	return va - vb, false
+}
+
+func subFloat64(va, vb float64) (float64, bool) {
+	diff := va - vb
+	if math.IsInf(diff, 0) && !math.IsInf(va, 0) && !math.IsInf(vb, 0) {
+		return 0, true
+	}
+	return diff, false
+}
+
+func mulInt64(va, vb int64) (int64, bool) {
+	if va == math.MinInt64 && vb == -1 || vb == math.MinInt64 && va == -1 {
+		return 0, true
+	}
+	if va == 0 || vb == 0 {
+		return 0, false
+	}
+	product := va * vb
+	if product/vb != va {
+		return 0, true
+	}
+	return product, false
+}
+
+func mulFloat64(va, vb float64) (float64, bool) {
+	product := va * vb
+	if math.IsInf(product, 0) && !math.IsInf(va, 0) && !math.IsInf(vb, 0) {
+		return 0, true
+	}
+	return product, false
+}
+
+func divInt64(va, vb int64) (int64, bool) {
+	if va == math.MinInt64 && vb == -1 {
+		return 0, true
+	}
+	return va / vb, false
+}
+
+// Add returns a + b. For int64 and float64 it returns an *OverflowError if
+// the result overflows the type's representable range.
+func Add[T Number](a, b T) (T, error) {
+	switch va := any(a).(type) {
+	case int64:
+		vb := any(b).(int64)
+		sum, overflowed := addInt64(va, vb)
+		if overflowed {
+			return a, overflow("Add", a, b)
+		}
+		return any(sum).(T), nil
+	case float64:
+		vb := any(b).(float64)
+		sum, overflowed := addFloat64(va, vb)
+		if overflowed {
+			return a, overflow("Add", a, b)
+		}
+		return any(sum).(T), nil
+	case *big.Int:
+		vb := any(b).(*big.Int)
+		return any(new(big.Int).Add(va, vb)).(T), nil
+	case *big.Float:
+		vb := any(b).(*big.Float)
+		return any(new(big.Float).Add(va, vb)).(T), nil
+	default:
+		// a's type isn't exactly int64/float64/*big.Int/*big.Float, so it
+		// must be a named type derived from int64 or float64 (e.g. `type
+		// Meters int64`) — the only other shape the Number constraint
+		// allows. Dispatch on the underlying kind instead.
+		rt := reflect.TypeOf(a)
+		switch rt.Kind() {
+		case reflect.Int64:
+			va, vb := reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()
+			sum, overflowed := addInt64(va, vb)
+			if overflowed {
+				return a, overflow("Add", a, b)
+			}
+			return asT[T](sum, rt), nil
+		case reflect.Float64:
+			va, vb := reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()
+			sum, overflowed := addFloat64(va, vb)
+			if overflowed {
+				return a, overflow("Add", a, b)
+			}
+			return asT[T](sum, rt), nil
+		default:
+			panic(fmt.Sprintf("mathx: unsupported type %T", a))
+		}
+	}
+}
+
+// Sub returns a - b. For int64 and float64 it returns an *OverflowError if
+// the result overflows the type's representable range.
+func Sub[T Number](a, b T) (T, error) {
+	switch va := any(a).(type) {
+	case int64:
+		vb := any(b).(int64)
+		diff, overflowed := subInt64(va, vb)
+		if overflowed {
+			return a, overflow("Sub", a, b)
+		}
+		return any(diff).(T), nil
+	case float64:
+		vb := any(b).(float64)
+		diff, overflowed := subFloat64(va, vb)
+		if overflowed {
+			return a, overflow("Sub", a, b)
+		}
+		return any(diff).(T), nil
+	case *big.Int:
+		vb := any(b).(*big.Int)
+		return any(new(big.Int).Sub(va, vb)).(T), nil
+	case *big.Float:
+		vb := any(b).(*big.Float)
+		return any(new(big.Float).Sub(va, vb)).(T), nil
+	default:
+		rt := reflect.TypeOf(a)
+		switch rt.Kind() {
+		case reflect.Int64:
+			va, vb := reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()
+			diff, overflowed := subInt64(va, vb)
+			if overflowed {
+				return a, overflow("Sub", a, b)
+			}
+			return asT[T](diff, rt), nil
+		case reflect.Float64:
+			va, vb := reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()
+			diff, overflowed := subFloat64(va, vb)
+			if overflowed {
+				return a, overflow("Sub", a, b)
+			}
+			return asT[T](diff, rt), nil
+		default:
+			panic(fmt.Sprintf("mathx: unsupported type %T", a))
+		}
+	}
+}
+
+// Mul returns a * b. For int64 and float64 it returns an *OverflowError if
+// the result overflows the type's representable range.
+func Mul[T Number](a, b T) (T, error) {
+	switch va := any(a).(type) {
+	case int64:
+		vb := any(b).(int64)
+		product, overflowed := mulInt64(va, vb)
+		if overflowed {
+			return a, overflow("Mul", a, b)
+		}
+		return any(product).(T), nil
+	case float64:
+		vb := any(b).(float64)
+		product, overflowed := mulFloat64(va, vb)
+		if overflowed {
+			return a, overflow("Mul", a, b)
+		}
+		return any(product).(T), nil
+	case *big.Int:
+		vb := any(b).(*big.Int)
+		return any(new(big.Int).Mul(va, vb)).(T), nil
+	case *big.Float:
+		vb := any(b).(*big.Float)
+		return any(new(big.Float).Mul(va, vb)).(T), nil
+	default:
+		rt := reflect.TypeOf(a)
+		switch rt.Kind() {
+		case reflect.Int64:
+			va, vb := reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()
+			product, overflowed := mulInt64(va, vb)
+			if overflowed {
+				return a, overflow("Mul", a, b)
+			}
+			return asT[T](product, rt), nil
+		case reflect.Float64:
+			va, vb := reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()
+			product, overflowed := mulFloat64(va, vb)
+			if overflowed {
+				return a, overflow("Mul", a, b)
+			}
+			return asT[T](product, rt), nil
+		default:
+			panic(fmt.Sprintf("mathx: unsupported type %T", a))
+		}
+	}
+}
+
+// Div returns a / b. It returns ErrDivideByZero if b is zero, and for
+// int64 an *OverflowError for the single case that overflows
+// (math.MinInt64 / -1).
+func Div[T Number](a, b T) (T, error) {
+	switch va := any(a).(type) {
+	case int64:
+		vb := any(b).(int64)
+		if vb == 0 {
+			return a, ErrDivideByZero
+		}
+		quotient, overflowed := divInt64(va, vb)
+		if overflowed {
+			return a, overflow("Div", a, b)
+		}
+		return any(quotient).(T), nil
+	case float64:
+		vb := any(b).(float64)
+		if vb == 0 {
+			return a, ErrDivideByZero
+		}
+		return any(va / vb).(T), nil
+	case *big.Int:
+		vb := any(b).(*big.Int)
+		if vb.Sign() == 0 {
+			return a, ErrDivideByZero
+		}
+		return any(new(big.Int).Quo(va, vb)).(T), nil
+	case *big.Float:
+		vb := any(b).(*big.Float)
+		if vb.Sign() == 0 {
+			return a, ErrDivideByZero
+		}
+		return any(new(big.Float).Quo(va, vb)).(T), nil
+	default:
+		rt := reflect.TypeOf(a)
+		switch rt.Kind() {
+		case reflect.Int64:
+			va, vb := reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()
+			if vb == 0 {
+				return a, ErrDivideByZero
+			}
+			quotient, overflowed := divInt64(va, vb)
+			if overflowed {
+				return a, overflow("Div", a, b)
+			}
+			return asT[T](quotient, rt), nil
+		case reflect.Float64:
+			va, vb := reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()
+			if vb == 0 {
+				return a, ErrDivideByZero
+			}
+			return asT[T](va/vb, rt), nil
+		default:
+			panic(fmt.Sprintf("mathx: unsupported type %T", a))
+		}
+	}
+}
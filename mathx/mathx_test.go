@@ -0,0 +1,216 @@
+package mathx
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestAddInt64(t *testing.T) {
+	got, err := Add(int64(2), int64(3))
+	if err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if got != 5 {
+		t.Errorf("Add(2, 3) = %d, want 5", got)
+	}
+}
+
+func TestAddInt64Overflow(t *testing.T) {
+	_, err := Add(int64(math.MaxInt64), int64(1))
+	if !IsOverflow(err) {
+		t.Fatalf("Add(MaxInt64, 1) error = %v, want overflow", err)
+	}
+}
+
+func TestSubInt64Overflow(t *testing.T) {
+	_, err := Sub(int64(math.MinInt64), int64(1))
+	if !IsOverflow(err) {
+		t.Fatalf("Sub(MinInt64, 1) error = %v, want overflow", err)
+	}
+}
+
+func TestMulInt64Overflow(t *testing.T) {
+	_, err := Mul(int64(math.MaxInt64), int64(2))
+	if !IsOverflow(err) {
+		t.Fatalf("Mul(MaxInt64, 2) error = %v, want overflow", err)
+	}
+}
+
+func TestMulInt64MinByNegOneOverflow(t *testing.T) {
+	_, err := Mul(int64(math.MinInt64), int64(-1))
+	if !IsOverflow(err) {
+		t.Fatalf("Mul(MinInt64, -1) error = %v, want overflow", err)
+	}
+}
+
+func TestDivInt64(t *testing.T) {
+	got, err := Div(int64(10), int64(4))
+	if err != nil {
+		t.Fatalf("Div returned error: %v", err)
+	}
+	if got != 2 {
+		t.Errorf("Div(10, 4) = %d, want 2", got)
+	}
+}
+
+func TestDivInt64ByZero(t *testing.T) {
+	_, err := Div(int64(10), int64(0))
+	if err != ErrDivideByZero {
+		t.Fatalf("Div(10, 0) error = %v, want ErrDivideByZero", err)
+	}
+}
+
+func TestDivInt64Overflow(t *testing.T) {
+	_, err := Div(int64(math.MinInt64), int64(-1))
+	if !IsOverflow(err) {
+		t.Fatalf("Div(MinInt64, -1) error = %v, want overflow", err)
+	}
+}
+
+func TestFloat64Overflow(t *testing.T) {
+	_, err := Mul(math.MaxFloat64, math.MaxFloat64)
+	if !IsOverflow(err) {
+		t.Fatalf("Mul(MaxFloat64, MaxFloat64) error = %v, want overflow", err)
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	a := big.NewInt(1)
+	a.Lsh(a, 100) // well beyond int64 range
+	b := big.NewInt(1)
+
+	got, err := Add(a, b)
+	if err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	want := new(big.Int).Add(a, b)
+	if got.Cmp(want) != 0 {
+		t.Errorf("Add(a, 1) = %s, want %s", got, want)
+	}
+}
+
+func TestBigIntDivByZero(t *testing.T) {
+	_, err := Div(big.NewInt(10), big.NewInt(0))
+	if err != ErrDivideByZero {
+		t.Fatalf("Div(10, 0) error = %v, want ErrDivideByZero", err)
+	}
+}
+
+// Meters is a named int64 type used to exercise the ~int64 arm of the
+// Number constraint: arithmetic must dispatch on the underlying kind, not
+// the exact type int64.
+type Meters int64
+
+func TestNamedInt64Type(t *testing.T) {
+	got, err := Add(Meters(2), Meters(3))
+	if err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if got != 5 {
+		t.Errorf("Add(Meters(2), Meters(3)) = %d, want 5", got)
+	}
+}
+
+func TestNamedInt64TypeOverflow(t *testing.T) {
+	_, err := Mul(Meters(math.MinInt64), Meters(-1))
+	if !IsOverflow(err) {
+		t.Fatalf("Mul(Meters(MinInt64), Meters(-1)) error = %v, want overflow", err)
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	a := big.NewFloat(1.5)
+	b := big.NewFloat(2.5)
+
+	got, err := Mul(a, b)
+	if err != nil {
+		t.Fatalf("Mul returned error: %v", err)
+	}
+	want := new(big.Float).Mul(a, b)
+	if got.Cmp(want) != 0 {
+		t.Errorf("Mul(a, b) = %s, want %s", got, want)
+	}
+}
+
+func FuzzAddInt64(f *testing.F) {
+	f.Add(int64(math.MaxInt64), int64(1))
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Add(int64(1), int64(2))
+
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got, err := Add(a, b)
+		if err != nil {
+			if !IsOverflow(err) {
+				t.Fatalf("Add(%d, %d) returned non-overflow error: %v", a, b, err)
+			}
+			return
+		}
+		if want := a + b; got != want {
+			t.Fatalf("Add(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzSubInt64(f *testing.F) {
+	f.Add(int64(math.MinInt64), int64(1))
+	f.Add(int64(math.MaxInt64), int64(-1))
+	f.Add(int64(5), int64(2))
+
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got, err := Sub(a, b)
+		if err != nil {
+			if !IsOverflow(err) {
+				t.Fatalf("Sub(%d, %d) returned non-overflow error: %v", a, b, err)
+			}
+			return
+		}
+		if want := a - b; got != want {
+			t.Fatalf("Sub(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzMulInt64(f *testing.F) {
+	f.Add(int64(math.MaxInt64), int64(2))
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Add(int64(3), int64(4))
+
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got, err := Mul(a, b)
+		if err != nil {
+			if !IsOverflow(err) {
+				t.Fatalf("Mul(%d, %d) returned non-overflow error: %v", a, b, err)
+			}
+			return
+		}
+		if want := a * b; got != want {
+			t.Fatalf("Mul(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzDivInt64(f *testing.F) {
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Add(int64(10), int64(0))
+	f.Add(int64(10), int64(4))
+
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got, err := Div(a, b)
+		if err != nil {
+			if err == ErrDivideByZero {
+				if b != 0 {
+					t.Fatalf("Div(%d, %d) returned ErrDivideByZero for nonzero b", a, b)
+				}
+				return
+			}
+			if !IsOverflow(err) {
+				t.Fatalf("Div(%d, %d) returned unexpected error: %v", a, b, err)
+			}
+			return
+		}
+		if want := a / b; got != want {
+			t.Fatalf("Div(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+// Package server exposes the standards library over HTTP.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MontyForged/monty-standards/greet"
+	"github.com/MontyForged/monty-standards/mathx"
+)
+
+// Server runs the HTTP API with graceful shutdown support.
+type Server struct {
+	httpServer *http.Server
+}
+
+// New builds a Server listening on addr.
+func New(addr string) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Addr:    addr,
+			Handler: NewHandler(),
+		},
+	}
+}
+
+// Run starts the server and blocks until ctx is cancelled, at which point
+// it shuts down gracefully, waiting up to 5 seconds for in-flight requests
+// to complete.
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+			return
+		}
+		errCh <- nil
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		return <-errCh
+	case err := <-errCh:
+		return err
+	}
+}
+
+// NewHandler builds the /v1 API handler, wrapped with request logging.
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/greet", handleGreet)
+	mux.HandleFunc("/v1/add", handleAdd)
+	return loggingMiddleware(mux)
+}
+
+func handleGreet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		name = "World"
+	}
+	lang := r.URL.Query().Get("lang")
+	if lang == "" {
+		lang = greet.DefaultLocale
+	}
+
+	if wantsJSON(r) {
+		g := greet.NewGreeter(greet.WithLocale(lang), greet.WithFormat(greet.FormatJSON))
+		msg, err := g.Greet(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, msg)
+		return
+	}
+
+	g := greet.NewGreeter(greet.WithLocale(lang))
+	msg, err := g.Greet(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, msg)
+}
+
+// addRequest is the POST /v1/add request body.
+type addRequest struct {
+	Values []float64 `json:"values"`
+}
+
+// addResponse is the POST /v1/add JSON response body.
+type addResponse struct {
+	Sum float64 `json:"sum"`
+}
+
+func handleAdd(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req addRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var sum float64
+	for _, v := range req.Values {
+		var err error
+		sum, err = mathx.Add(sum, v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+	}
+
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(addResponse{Sum: sum})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, sum)
+}
+
+// wantsJSON reports whether the request prefers a JSON response, defaulting
+// to true unless the client explicitly asks for text/plain.
+func wantsJSON(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	switch {
+	case strings.Contains(accept, "application/json"):
+		return true
+	case strings.Contains(accept, "text/plain"):
+		return false
+	default:
+		return true
+	}
+}
+
+// statusWriter captures the status code written by the wrapped handler so
+// loggingMiddleware can record it after the fact.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func loggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+		log.Printf("%s %s %d %s", r.Method, r.URL.Path, sw.status, time.Since(start))
+	})
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleGreet(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		accept   string
+		wantBody string
+		wantType string
+	}{
+		{
+			name:     "plain default",
+			url:      "/v1/greet?name=World",
+			accept:   "text/plain",
+			wantBody: "Hello, World!\n",
+			wantType: "text/plain; charset=utf-8",
+		},
+		{
+			name:     "json",
+			url:      "/v1/greet?name=Ada&lang=es",
+			accept:   "application/json",
+			wantBody: `{"name":"Ada","message":"¡Hola, Ada!"}` + "\n",
+			wantType: "application/json",
+		},
+		{
+			name:     "defaults without name",
+			url:      "/v1/greet",
+			accept:   "text/plain",
+			wantBody: "Hello, World!\n",
+			wantType: "text/plain; charset=utf-8",
+		},
+	}
+
+	h := NewHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", rec.Code)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestHandleAdd(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/add", strings.NewReader(`{"values":[1,2,3.5]}`))
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if want := `{"sum":6.5}` + "\n"; rec.Body.String() != want {
+		t.Errorf("body = %q, want %q", rec.Body.String(), want)
+	}
+}
+
+func TestHandleAddMethodNotAllowed(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/add", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405", rec.Code)
+	}
+}
+
+func TestHandleAddBadBody(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/add", strings.NewReader(`not json`))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestServerRunGracefulShutdown(t *testing.T) {
+	srv := New("127.0.0.1:0")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Run(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run returned error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after context cancellation")
+	}
+}
@@ -0,0 +1,16 @@
+// Package standards is the public API of monty-standards: a small library
+// of building blocks (greetings, arithmetic, and friends) shared across the
+// project's commands and services.
+package standards
+
+import "fmt"
+
+// Greet returns a friendly greeting for name.
+func Greet(name string) string {
+	return fmt.Sprintf("Hello, %s!", name)
+}
+
+// Add returns the sum of a and b.
+func Add(a, b int) int {
+	return a + b
+}
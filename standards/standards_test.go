@@ -0,0 +1,15 @@
+package standards
+
+import "testing"
+
+func TestGreet(t *testing.T) {
+	if got := Greet("World"); got != "Hello, World!" {
+		t.Errorf("Greet(%q) = %q, want %q", "World", got, "Hello, World!")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	if got := Add(2, 3); got != 5 {
+		t.Errorf("Add(2, 3) = %d, want 5", got)
+	}
+}